@@ -18,7 +18,6 @@
 package changelog
 
 import (
-	"bufio"
 	"fmt"
 	"io"
 	"regexp"
@@ -32,6 +31,10 @@ var (
 	linksRegex     = regexp.MustCompile(`(?i)^\s*\[([^\]]*)\]\s*:\s*(https?://.*?)\s*$`)
 	releaseRegex   = regexp.MustCompile(`(?i)^\s*##\s+(\[([^\]]*)\]\s*-?\s*(\d{4}-\d\d-\d\d)?\s*(\[\s*YANKED\s*\])?)\s*$`)
 	detailsRegex   = regexp.MustCompile(`(?i)^\s*###\s+(Added|Changed|Deprecated|Fixed|Removed|Security)\s*$`)
+
+	// sectionHeadingRegex matches any '### ...' heading, recognized or
+	// not, so unrecognized ones can be flagged by Validate.
+	sectionHeadingRegex = regexp.MustCompile(`^\s*###\s+(\S.*?)\s*$`)
 )
 
 // The Changelog structure contains the entire changelog.  It may be populated
@@ -57,6 +60,11 @@ type Changelog struct {
 
 	// The collection of links showing the differences between release versions.
 	Links []Link
+
+	// The URL template used by PromoteUnreleased to regenerate compare
+	// links, e.g. "https://example.com/compare/{previous}...{current}".
+	// Set via SetLinkTemplate.
+	linkTemplate string
 }
 
 // Parse takes a bufio.Scanner and processes the file into
@@ -64,7 +72,7 @@ func Parse(r io.Reader) (*Changelog, error) {
 
 	rv := Changelog{}
 
-	s := bufio.NewScanner(r)
+	s := newLineScanner(r)
 
 	err := rv.addHeaders(s)
 	if err != nil {
@@ -85,34 +93,6 @@ func Parse(r io.Reader) (*Changelog, error) {
 	return &rv, nil
 }
 
-// ToMarkdown converts the Changelog structure into a markdown formatted stream of
-// characters and returns the string.
-func (cl *Changelog) ToMarkdown() string {
-	out := ""
-	for _, line := range cl.CommentHeader {
-		out += line + "\n"
-	}
-
-	out += "# " + cl.Title + "\n\n"
-
-	for _, line := range cl.Description {
-		out += line + "\n"
-	}
-
-	for _, r := range cl.Releases {
-		out += "\n\n" + r.ToMarkdown()
-	}
-
-	if 0 < len(cl.Links) {
-		out += "\n\n"
-		for _, link := range cl.Links {
-			out += link.ToMarkdown()
-		}
-	}
-
-	return out
-}
-
 // evalDesc looks at the description and finds if there are versions for the
 // semver or for keep a changelog version and populates that information.
 func (cl *Changelog) evalDesc() {
@@ -132,7 +112,7 @@ func (cl *Changelog) evalDesc() {
 }
 
 // addHeaders adds the header comments if present to the changelog object.
-func (cl *Changelog) addHeaders(s *bufio.Scanner) error {
+func (cl *Changelog) addHeaders(s *lineScanner) error {
 	for {
 		if titleRegex.MatchString(s.Text()) {
 			re := regexp.MustCompile(`^\s*(<!--.*-->)?\s*$`)
@@ -155,7 +135,7 @@ func (cl *Changelog) addHeaders(s *bufio.Scanner) error {
 }
 
 // addReleases adds all the found releases to the changelog object.
-func (cl *Changelog) addReleases(s *bufio.Scanner) error {
+func (cl *Changelog) addReleases(s *lineScanner) error {
 	for {
 		r, err := newRelease(s)
 		if err != nil {
@@ -170,7 +150,7 @@ func (cl *Changelog) addReleases(s *bufio.Scanner) error {
 }
 
 // addTitleBlock adds the title block information to the changelog object.
-func (cl *Changelog) addTitleBlock(s *bufio.Scanner) {
+func (cl *Changelog) addTitleBlock(s *lineScanner) {
 	title := titleRegex.FindStringSubmatch(s.Text())
 	// Because the title was found in addHeaders() it must be valid here
 
@@ -189,13 +169,14 @@ func (cl *Changelog) addTitleBlock(s *bufio.Scanner) {
 }
 
 // addLinks adds the links (if present) to the changelog object.
-func (cl *Changelog) addLinks(s *bufio.Scanner) {
+func (cl *Changelog) addLinks(s *lineScanner) {
 	for {
 		found := linksRegex.FindStringSubmatch(s.Text())
 		if found != nil {
 			link := Link{
 				Version: found[1],
 				Url:     found[2],
+				Line:    s.line,
 			}
 			cl.Links = append(cl.Links, link)
 		}
@@ -249,6 +230,23 @@ type Release struct {
 
 	// The entire body of the release in case that is useful.
 	Body []string
+
+	// The 1-based line number of the '## [...]' release header in the
+	// parsed file.  Zero for a release that wasn't parsed from a file.
+	Line int
+
+	// The '### ...' section headers encountered while parsing this
+	// release, in file order, used by Validate to flag unknown or
+	// duplicated headings.
+	headings []headingOccurrence
+}
+
+// headingOccurrence records a single '### ...' section header encountered
+// while parsing a release.
+type headingOccurrence struct {
+	line  int
+	name  string
+	known bool
 }
 
 // ToMarkdown converts the Release structure into a markdown formatted stream of
@@ -304,7 +302,7 @@ func (r *Release) ToMarkdown() string {
 // newRelease attempts to create a new release object based off the stream of
 // data from the scanner.  When it returns (nil, nil) there is nothing left to
 // do and there are no more releases
-func newRelease(s *bufio.Scanner) (*Release, error) {
+func newRelease(s *lineScanner) (*Release, error) {
 	if !releaseRegex.MatchString(s.Text()) {
 		return nil, nil
 	}
@@ -315,6 +313,7 @@ func newRelease(s *bufio.Scanner) (*Release, error) {
 		Body:    []string{s.Text()},
 		Title:   found[1],
 		Version: found[2],
+		Line:    s.line,
 	}
 
 	unreleased := false
@@ -351,9 +350,14 @@ func newRelease(s *bufio.Scanner) (*Release, error) {
 		found := detailsRegex.FindStringSubmatch(text)
 		if found != nil {
 			lastDetail = strings.ToLower(found[1])
+			r.headings = append(r.headings, headingOccurrence{line: s.line, name: canonicalSection(found[1]), known: true})
 			continue
 		}
 
+		if found := sectionHeadingRegex.FindStringSubmatch(text); found != nil {
+			r.headings = append(r.headings, headingOccurrence{line: s.line, name: found[1], known: false})
+		}
+
 		r.appendTo(lastDetail, text)
 	}
 }
@@ -387,6 +391,10 @@ type Link struct {
 	// The following URL that describes the difference between this release and
 	// the previous release
 	Url string
+
+	// The 1-based line number of this link in the parsed file.  Zero for a
+	// link that wasn't parsed from a file.
+	Line int
 }
 
 // ToMarkdown converts the Link structure into a markdown formatted stream of
@@ -0,0 +1,168 @@
+/**
+ * Copyright 2021 Comcast Cable Communications Management, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AddEntry appends text as a new bullet point under the given section
+// (Added, Changed, Deprecated, Removed, Fixed, or Security, matched
+// case-insensitively) of the Unreleased release.  If the Changelog has no
+// Unreleased release yet, one is created at the front of Releases.  It
+// returns an error if section isn't one of the recognized headings.
+func (cl *Changelog) AddEntry(section, text string) error {
+	switch strings.ToLower(section) {
+	case "added", "changed", "deprecated", "removed", "fixed", "security":
+	default:
+		return fmt.Errorf("unknown section: '%s'", section)
+	}
+
+	rel, ok := cl.unreleased()
+	if !ok {
+		cl.Releases = append([]Release{{Title: "[Unreleased]", Version: "Unreleased"}}, cl.Releases...)
+		rel = &cl.Releases[0]
+	}
+
+	line := "- " + text
+	switch strings.ToLower(section) {
+	case "added":
+		rel.Added = append(rel.Added, line)
+	case "changed":
+		rel.Changed = append(rel.Changed, line)
+	case "deprecated":
+		rel.Deprecated = append(rel.Deprecated, line)
+	case "removed":
+		rel.Removed = append(rel.Removed, line)
+	case "fixed":
+		rel.Fixed = append(rel.Fixed, line)
+	case "security":
+		rel.Security = append(rel.Security, line)
+	}
+
+	return nil
+}
+
+// SetLinkTemplate sets the URL template PromoteUnreleased uses to
+// regenerate compare links when a compareURL isn't supplied directly.
+// The template should contain the placeholders '{previous}' and
+// '{current}', e.g. "https://example.com/compare/{previous}...{current}".
+func (cl *Changelog) SetLinkTemplate(tmpl string) {
+	cl.linkTemplate = tmpl
+}
+
+// PromoteUnreleased cuts a release: it renames the current Unreleased
+// release to version, dated date, installs a fresh empty Unreleased
+// release in its place, and rewrites the Links slice so the
+// Unreleased/HEAD link and the new release's compare link point at the
+// right versions.
+//
+// compareURL is a link template, as described by SetLinkTemplate.  If
+// compareURL is empty, the template set by SetLinkTemplate is used
+// instead.  It returns the newly cut release, or an error if there is no
+// Unreleased release or no template is available.
+func (cl *Changelog) PromoteUnreleased(version string, date time.Time, compareURL string) (*Release, error) {
+	tmpl := compareURL
+	if tmpl == "" {
+		tmpl = cl.linkTemplate
+	}
+	if tmpl == "" {
+		return nil, fmt.Errorf("no link template available; call SetLinkTemplate or pass compareURL")
+	}
+
+	idx := -1
+	for i := range cl.Releases {
+		if strings.EqualFold(cl.Releases[i].Version, "unreleased") {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return nil, fmt.Errorf("no Unreleased release found")
+	}
+
+	previous := ""
+	if idx+1 < len(cl.Releases) {
+		previous = cl.Releases[idx+1].Version
+	}
+
+	d := date
+	cut := cl.Releases[idx]
+	cut.Version = version
+	cut.Title = "[" + version + "] - " + date.Format("2006-01-02")
+	cut.Date = &d
+
+	fresh := Release{Title: "[Unreleased]", Version: "Unreleased"}
+
+	releases := make([]Release, 0, len(cl.Releases)+1)
+	releases = append(releases, cl.Releases[:idx]...)
+	releases = append(releases, fresh, cut)
+	releases = append(releases, cl.Releases[idx+1:]...)
+	cl.Releases = releases
+
+	if !cl.hasLink("Unreleased") {
+		cl.Links = append([]Link{{Version: "Unreleased"}}, cl.Links...)
+	}
+	cl.setLink("Unreleased", renderLinkTemplate(tmpl, version, "HEAD"))
+	cl.insertLinkAfter("Unreleased", Link{Version: version, Url: renderLinkTemplate(tmpl, previous, version)})
+
+	return &cl.Releases[idx+1], nil
+}
+
+// renderLinkTemplate substitutes the '{previous}' and '{current}'
+// placeholders in tmpl.
+func renderLinkTemplate(tmpl, previous, current string) string {
+	r := strings.NewReplacer("{previous}", previous, "{current}", current)
+	return r.Replace(tmpl)
+}
+
+// hasLink reports whether a Link matching version already exists.
+func (cl *Changelog) hasLink(version string) bool {
+	for i := range cl.Links {
+		if strings.EqualFold(cl.Links[i].Version, version) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setLink updates the URL of the Link matching version, if one exists.
+func (cl *Changelog) setLink(version, url string) {
+	for i := range cl.Links {
+		if strings.EqualFold(cl.Links[i].Version, version) {
+			cl.Links[i].Url = url
+			return
+		}
+	}
+}
+
+// insertLinkAfter inserts link immediately after the Link matching
+// afterVersion, or at the front of Links if no such link exists.
+func (cl *Changelog) insertLinkAfter(afterVersion string, link Link) {
+	for i := range cl.Links {
+		if strings.EqualFold(cl.Links[i].Version, afterVersion) {
+			cl.Links = append(cl.Links[:i+1], append([]Link{link}, cl.Links[i+1:]...)...)
+			return
+		}
+	}
+
+	cl.Links = append([]Link{link}, cl.Links...)
+}
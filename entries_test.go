@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddEntry(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getUnreleased(""))
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	assert.Nil(cl.AddEntry("Added", "A new Foo() function."))
+	assert.Nil(cl.AddEntry("fixed", "A panic in Bar()."))
+	assert.NotNil(cl.AddEntry("bogus", "Should fail."))
+
+	r, ok := cl.unreleased()
+	assert.True(ok)
+	assert.Equal([]string{"- A new Foo() function."}, r.Added)
+	assert.Equal([]string{"- A panic in Bar()."}, r.Fixed)
+}
+
+func TestAddEntryUnknownSectionLeavesReleasesUntouched(t *testing.T) {
+	assert := assert.New(t)
+
+	cl := &Changelog{Title: "Changelog"}
+
+	assert.NotNil(cl.AddEntry("bogus", "Should fail."))
+	assert.Equal(0, len(cl.Releases))
+}
+
+func TestAddEntryNoExistingUnreleased(t *testing.T) {
+	assert := assert.New(t)
+
+	cl := &Changelog{Title: "Changelog"}
+
+	assert.Nil(cl.AddEntry("Added", "A new Foo() function."))
+	assert.Equal(1, len(cl.Releases))
+	assert.Equal("Unreleased", cl.Releases[0].Version)
+}
+
+func TestPromoteUnreleased(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	cl.SetLinkTemplate("https://example.com/compare/{previous}...{current}")
+
+	date := time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)
+	r, err := cl.PromoteUnreleased("v3.5.0", date, "")
+	assert.Nil(err)
+	assert.NotNil(r)
+
+	assert.Equal("v3.5.0", r.Version)
+	assert.Equal("[v3.5.0] - 2021-01-02", r.Title)
+	assert.NotNil(r.Date)
+
+	assert.Equal(6, len(cl.Releases))
+	assert.Equal("Unreleased", cl.Releases[0].Version)
+	assert.Equal(0, len(cl.Releases[0].Added))
+	assert.Equal("v3.5.0", cl.Releases[1].Version)
+
+	assert.Equal("Unreleased", cl.Links[0].Version)
+	assert.Equal("https://example.com/compare/v3.5.0...HEAD", cl.Links[0].Url)
+	assert.Equal("v3.5.0", cl.Links[1].Version)
+	assert.Equal("https://example.com/compare/v3.4.0...v3.5.0", cl.Links[1].Url)
+}
+
+func TestPromoteUnreleasedNoTemplate(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	_, err = cl.PromoteUnreleased("v3.5.0", time.Now(), "")
+	assert.NotNil(err)
+}
@@ -0,0 +1,210 @@
+/**
+ * Copyright 2021 Comcast Cable Communications Management, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package changelog
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// changelogJSON is the wire format used by Changelog.MarshalJSON and
+// Changelog.UnmarshalJSON.  KeepAChangelogVersion and SemVerVersion aren't
+// included since they're derived from Description by evalDesc.
+type changelogJSON struct {
+	CommentHeader []string  `json:"commentHeader,omitempty"`
+	Title         string    `json:"title"`
+	Description   []string  `json:"description,omitempty"`
+	Releases      []Release `json:"releases"`
+	Links         []Link    `json:"links,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (cl *Changelog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(changelogJSON{
+		CommentHeader: cl.CommentHeader,
+		Title:         cl.Title,
+		Description:   cl.Description,
+		Releases:      cl.Releases,
+		Links:         cl.Links,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (cl *Changelog) UnmarshalJSON(data []byte) error {
+	var dto changelogJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return err
+	}
+
+	cl.CommentHeader = dto.CommentHeader
+	cl.Title = dto.Title
+	cl.Description = dto.Description
+	cl.Releases = dto.Releases
+	cl.Links = dto.Links
+	cl.evalDesc()
+
+	return nil
+}
+
+// ToJSON marshals the Changelog to JSON.  It's a thin convenience wrapper
+// over json.Marshal.
+func (cl *Changelog) ToJSON() ([]byte, error) {
+	return json.Marshal(cl)
+}
+
+// FromJSON parses a Changelog from JSON produced by ToJSON.
+func FromJSON(data []byte) (*Changelog, error) {
+	var cl Changelog
+	if err := json.Unmarshal(data, &cl); err != nil {
+		return nil, err
+	}
+
+	return &cl, nil
+}
+
+// releaseJSON is the wire format used by Release.MarshalJSON and
+// Release.UnmarshalJSON.  Body, Line, and headings are parser bookkeeping
+// and aren't included.
+type releaseJSON struct {
+	Title      string   `json:"title"`
+	Version    string   `json:"version"`
+	Date       *string  `json:"date,omitempty"`
+	Yanked     bool     `json:"yanked,omitempty"`
+	Other      []string `json:"other,omitempty"`
+	Added      []string `json:"added,omitempty"`
+	Changed    []string `json:"changed,omitempty"`
+	Deprecated []string `json:"deprecated,omitempty"`
+	Removed    []string `json:"removed,omitempty"`
+	Fixed      []string `json:"fixed,omitempty"`
+	Security   []string `json:"security,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.  The date, if present, is
+// rendered as an ISO-8601 'YYYY-MM-DD' string.
+func (r Release) MarshalJSON() ([]byte, error) {
+	dto := releaseJSON{
+		Title:      r.Title,
+		Version:    r.Version,
+		Yanked:     r.Yanked,
+		Other:      r.Other,
+		Added:      r.Added,
+		Changed:    r.Changed,
+		Deprecated: r.Deprecated,
+		Removed:    r.Removed,
+		Fixed:      r.Fixed,
+		Security:   r.Security,
+	}
+
+	if r.Date != nil {
+		s := r.Date.Format("2006-01-02")
+		dto.Date = &s
+	}
+
+	return json.Marshal(dto)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *Release) UnmarshalJSON(data []byte) error {
+	var dto releaseJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return err
+	}
+
+	r.Title = dto.Title
+	r.Version = dto.Version
+	r.Yanked = dto.Yanked
+	r.Other = dto.Other
+	r.Added = dto.Added
+	r.Changed = dto.Changed
+	r.Deprecated = dto.Deprecated
+	r.Removed = dto.Removed
+	r.Fixed = dto.Fixed
+	r.Security = dto.Security
+	r.Date = nil
+
+	if dto.Date != nil {
+		t, err := time.Parse("2006-01-02", *dto.Date)
+		if err != nil {
+			return fmt.Errorf("invalid date '%s': %w", *dto.Date, err)
+		}
+		r.Date = &t
+	}
+
+	return nil
+}
+
+// linkJSON is the wire format used by Link.MarshalJSON and
+// Link.UnmarshalJSON.  Line is parser bookkeeping and isn't included.
+type linkJSON struct {
+	Version string `json:"version"`
+	Url     string `json:"url"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l Link) MarshalJSON() ([]byte, error) {
+	return json.Marshal(linkJSON{Version: l.Version, Url: l.Url})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *Link) UnmarshalJSON(data []byte) error {
+	var dto linkJSON
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return err
+	}
+
+	l.Version = dto.Version
+	l.Url = dto.Url
+
+	return nil
+}
+
+// FileCopyrightText is the value of an SPDX-FileCopyrightText tag.
+type FileCopyrightText string
+
+// LicenseIdentifier is the value of an SPDX-License-Identifier tag.
+type LicenseIdentifier string
+
+var (
+	spdxCopyrightRegex = regexp.MustCompile(`(?i)^\s*SPDX-FileCopyrightText:\s*(.+?)\s*$`)
+	spdxLicenseRegex   = regexp.MustCompile(`(?i)^\s*SPDX-License-Identifier:\s*(.+?)\s*$`)
+)
+
+// SPDXHeader scans CommentHeader for SPDX-FileCopyrightText and
+// SPDX-License-Identifier tags (see https://spdx.dev/ids/) and returns
+// them as typed values.  ok is false unless both tags were found.
+func (cl *Changelog) SPDXHeader() (FileCopyrightText, LicenseIdentifier, bool) {
+	var copyright FileCopyrightText
+	var license LicenseIdentifier
+	var haveCopyright, haveLicense bool
+
+	for _, line := range cl.CommentHeader {
+		if found := spdxCopyrightRegex.FindStringSubmatch(line); found != nil {
+			copyright = FileCopyrightText(found[1])
+			haveCopyright = true
+		}
+
+		if found := spdxLicenseRegex.FindStringSubmatch(line); found != nil {
+			license = LicenseIdentifier(found[1])
+			haveLicense = true
+		}
+	}
+
+	return copyright, license, haveCopyright && haveLicense
+}
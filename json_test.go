@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangelogJSONRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	data, err := cl.ToJSON()
+	assert.Nil(err)
+	assert.NotEmpty(data)
+
+	got, err := FromJSON(data)
+	assert.Nil(err)
+	assert.NotNil(got)
+
+	assert.Equal(cl.ToMarkdown(), got.ToMarkdown())
+}
+
+func TestReleaseJSONDateFormat(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	data, err := cl.ToJSON()
+	assert.Nil(err)
+	assert.Contains(string(data), `"date":"2020-12-30"`)
+}
+
+func TestSPDXHeader(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	copyright, license, ok := cl.SPDXHeader()
+	assert.True(ok)
+	assert.Equal(FileCopyrightText("2021 Comcast Cable Communications Management, LLC"), copyright)
+	assert.Equal(LicenseIdentifier("Apache-2.0"), license)
+}
+
+func TestSPDXHeaderMissing(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getUnreleased(""))
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	_, _, ok := cl.SPDXHeader()
+	assert.False(ok)
+}
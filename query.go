@@ -0,0 +1,117 @@
+/**
+ * Copyright 2021 Comcast Cable Communications Management, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package changelog
+
+import (
+	"sort"
+	"strings"
+)
+
+// Release looks up the release with the given version, tolerating an
+// optional leading 'v' on either side and matching "Unreleased"
+// case-insensitively.  It returns false if no such release exists.
+func (cl *Changelog) Release(version string) (*Release, bool) {
+	for i := range cl.Releases {
+		if versionsEqual(cl.Releases[i].Version, version) {
+			return &cl.Releases[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// Since returns every release with a version strictly greater than
+// version, newest first, following semver precedence.  The Unreleased
+// release is never included, since it hasn't shipped yet.  It returns nil
+// if version isn't valid semver.
+func (cl *Changelog) Since(version string) []Release {
+	base, err := parseSemVer(version)
+	if err != nil {
+		return nil
+	}
+
+	var out []Release
+	for _, r := range cl.SortedReleases() {
+		if strings.EqualFold(r.Version, "unreleased") {
+			continue
+		}
+
+		v, err := parseSemVer(r.Version)
+		if err != nil {
+			continue
+		}
+
+		if v.compare(base) > 0 {
+			out = append(out, r)
+		}
+	}
+
+	return out
+}
+
+// SortedReleases returns a copy of Releases ordered by semver precedence
+// rather than file order: Unreleased first, then valid semantic versions
+// newest to oldest, then anything that isn't valid semver, in the order
+// it appeared in the file.
+func (cl *Changelog) SortedReleases() []Release {
+	out := append([]Release(nil), cl.Releases...)
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return releaseOrderLess(out[i], out[j])
+	})
+
+	return out
+}
+
+// releaseOrderLess reports whether a should sort before b under
+// SortedReleases' ordering.
+func releaseOrderLess(a, b Release) bool {
+	aUnreleased := strings.EqualFold(a.Version, "unreleased")
+	bUnreleased := strings.EqualFold(b.Version, "unreleased")
+
+	switch {
+	case aUnreleased && bUnreleased:
+		return false
+	case aUnreleased:
+		return true
+	case bUnreleased:
+		return false
+	}
+
+	av, aErr := parseSemVer(a.Version)
+	bv, bErr := parseSemVer(b.Version)
+
+	switch {
+	case aErr == nil && bErr == nil:
+		return av.compare(bv) > 0
+	case aErr == nil:
+		return true
+	default:
+		return false
+	}
+}
+
+// versionsEqual reports whether a and b refer to the same version,
+// tolerating an optional leading 'v' on either side.
+func versionsEqual(a, b string) bool {
+	if c, err := compareSemVer(a, b); err == nil {
+		return c == 0
+	}
+
+	return strings.EqualFold(strings.TrimPrefix(a, "v"), strings.TrimPrefix(b, "v"))
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+package changelog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelease(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	r, ok := cl.Release("v3.0.0")
+	assert.True(ok)
+	assert.Equal("v3.0.0", r.Version)
+
+	r, ok = cl.Release("3.0.0")
+	assert.True(ok)
+	assert.Equal("v3.0.0", r.Version)
+
+	r, ok = cl.Release("unreleased")
+	assert.True(ok)
+	assert.Equal("Unreleased", r.Version)
+
+	_, ok = cl.Release("v9.9.9")
+	assert.False(ok)
+}
+
+func TestSortedReleases(t *testing.T) {
+	assert := assert.New(t)
+
+	body := `
+# Changelog
+
+## [v1.0.0] - 2020-01-01
+
+## [Unreleased]
+
+## [v2.0.0] - 2021-01-01
+`
+	cl, err := Parse(strings.NewReader(body))
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	sorted := cl.SortedReleases()
+	assert.Equal(3, len(sorted))
+	assert.Equal("Unreleased", sorted[0].Version)
+	assert.Equal("v2.0.0", sorted[1].Version)
+	assert.Equal("v1.0.0", sorted[2].Version)
+}
+
+func TestSince(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	since := cl.Since("v2.1.0")
+	assert.Equal(2, len(since))
+	assert.Equal("v3.4.0", since[0].Version)
+	assert.Equal("v3.0.0", since[1].Version)
+}
+
+func TestSinceInvalidBase(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	assert.Nil(cl.Since("not-a-version"))
+}
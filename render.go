@@ -0,0 +1,321 @@
+/**
+ * Copyright 2021 Comcast Cable Communications Management, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Renderer converts a parsed Changelog, or a single Release, into an
+// output format.
+type Renderer interface {
+	// Render writes the entire Changelog to w.
+	Render(w io.Writer, cl *Changelog) error
+
+	// RenderRelease writes a single release to w.
+	RenderRelease(w io.Writer, r *Release) error
+}
+
+// inlineLinkRegex matches an inline markdown link, e.g.
+// "[issue 55](https://example.com/issue-55)".
+var inlineLinkRegex = regexp.MustCompile(`\[([^\]]+)\]\(([^)]+)\)`)
+
+// ToMarkdown converts the Changelog structure into a markdown formatted
+// stream of characters and returns the string.  It's a thin wrapper
+// around MarkdownRenderer for backward compatibility.
+func (cl *Changelog) ToMarkdown() string {
+	var buf strings.Builder
+	_ = MarkdownRenderer{}.Render(&buf, cl)
+	return buf.String()
+}
+
+// MarkdownRenderer renders a Changelog back into Keep a Changelog
+// flavored markdown, matching the format Parse accepts.
+type MarkdownRenderer struct{}
+
+var _ Renderer = MarkdownRenderer{}
+
+// Render writes cl's markdown representation to w.
+func (MarkdownRenderer) Render(w io.Writer, cl *Changelog) error {
+	out := ""
+	for _, line := range cl.CommentHeader {
+		out += line + "\n"
+	}
+
+	out += "# " + cl.Title + "\n\n"
+
+	for _, line := range cl.Description {
+		out += line + "\n"
+	}
+
+	for _, r := range cl.Releases {
+		out += "\n\n" + r.ToMarkdown()
+	}
+
+	if 0 < len(cl.Links) {
+		out += "\n\n"
+		for _, link := range cl.Links {
+			out += link.ToMarkdown()
+		}
+	}
+
+	_, err := io.WriteString(w, out)
+	return err
+}
+
+// RenderRelease writes r's markdown representation to w.
+func (MarkdownRenderer) RenderRelease(w io.Writer, r *Release) error {
+	_, err := io.WriteString(w, r.ToMarkdown())
+	return err
+}
+
+// PlainTextRenderer renders a Changelog as unadorned plain text, with
+// markdown headings stripped and inline links rewritten as "label (url)".
+type PlainTextRenderer struct{}
+
+var _ Renderer = PlainTextRenderer{}
+
+// Render writes cl's plain text representation to w.
+func (p PlainTextRenderer) Render(w io.Writer, cl *Changelog) error {
+	fmt.Fprintln(w, cl.Title)
+	fmt.Fprintln(w, strings.Repeat("=", len(cl.Title)))
+
+	if len(cl.Description) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, stripInlineLinks(strings.Join(cl.Description, " ")))
+	}
+
+	for i := range cl.Releases {
+		fmt.Fprintln(w)
+		if err := p.RenderRelease(w, &cl.Releases[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RenderRelease writes r's plain text representation to w.
+func (PlainTextRenderer) RenderRelease(w io.Writer, r *Release) error {
+	heading := r.Version
+	if r.Date != nil {
+		heading += " - " + r.Date.Format("2006-01-02")
+	}
+	if r.Yanked {
+		heading += " [YANKED]"
+	}
+
+	fmt.Fprintln(w, heading)
+	fmt.Fprintln(w, strings.Repeat("-", len(heading)))
+
+	for _, sec := range releaseSections(r) {
+		if len(sec.lines) == 0 {
+			continue
+		}
+
+		if sec.header != "" {
+			fmt.Fprintln(w)
+			fmt.Fprintln(w, sec.header+":")
+		}
+
+		for _, line := range sec.lines {
+			fmt.Fprintln(w, stripInlineLinks(line))
+		}
+	}
+
+	return nil
+}
+
+// HTMLRenderer renders a Changelog as an HTML fragment: a heading per
+// release, a sub-heading per section, and a list of entries, with
+// entries escaped and inline markdown links turned into anchors.
+type HTMLRenderer struct{}
+
+var _ Renderer = HTMLRenderer{}
+
+// Render writes cl's HTML representation to w.
+func (h HTMLRenderer) Render(w io.Writer, cl *Changelog) error {
+	fmt.Fprintf(w, "<h1>%s</h1>\n", html.EscapeString(cl.Title))
+
+	if len(cl.Description) > 0 {
+		fmt.Fprintf(w, "<p>%s</p>\n", renderInlineHTML(strings.Join(cl.Description, " ")))
+	}
+
+	for i := range cl.Releases {
+		if err := h.RenderRelease(w, &cl.Releases[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RenderRelease writes r's HTML representation to w.
+func (HTMLRenderer) RenderRelease(w io.Writer, r *Release) error {
+	heading := "[" + r.Version + "]"
+	if r.Date != nil {
+		heading += " - " + r.Date.Format("2006-01-02")
+	}
+	if r.Yanked {
+		heading += " [YANKED]"
+	}
+
+	fmt.Fprintf(w, "<h2>%s</h2>\n", html.EscapeString(heading))
+
+	for _, sec := range releaseSections(r) {
+		if len(sec.lines) == 0 {
+			continue
+		}
+
+		if sec.header != "" {
+			fmt.Fprintf(w, "<h3>%s</h3>\n", html.EscapeString(sec.header))
+		}
+
+		fmt.Fprintln(w, "<ul>")
+		for _, line := range sec.lines {
+			fmt.Fprintf(w, "<li>%s</li>\n", renderInlineHTML(trimBullet(line)))
+		}
+		fmt.Fprintln(w, "</ul>")
+	}
+
+	return nil
+}
+
+// GitHubReleaseRenderer renders the body of a single release in the
+// format expected by 'gh release create --notes-file'.  When Links
+// carries a compare link for the release's version, the heading becomes
+// a clickable reference to it.
+type GitHubReleaseRenderer struct {
+	// Links is consulted to resolve a release's '[vX.Y.Z]' heading to a
+	// clickable URL.  Optional; the heading renders as plain text if no
+	// Link matches.
+	Links []Link
+}
+
+var _ Renderer = GitHubReleaseRenderer{}
+
+// Render writes the body of the first release in cl.Releases, in file
+// order, that isn't Unreleased to w, resolving its heading against
+// cl.Links.
+func (g GitHubReleaseRenderer) Render(w io.Writer, cl *Changelog) error {
+	g.Links = cl.Links
+
+	for i := range cl.Releases {
+		if !strings.EqualFold(cl.Releases[i].Version, "unreleased") {
+			return g.RenderRelease(w, &cl.Releases[i])
+		}
+	}
+
+	return fmt.Errorf("no release to render")
+}
+
+// RenderRelease writes r's body to w, resolving its heading against g.Links.
+func (g GitHubReleaseRenderer) RenderRelease(w io.Writer, r *Release) error {
+	heading := "[" + r.Version + "]"
+	for _, l := range g.Links {
+		if strings.EqualFold(l.Version, r.Version) {
+			heading = "[" + r.Version + "](" + l.Url + ")"
+			break
+		}
+	}
+
+	if r.Yanked {
+		heading += " [YANKED]"
+	}
+
+	fmt.Fprintf(w, "## %s\n", heading)
+
+	for _, sec := range releaseSections(r) {
+		if len(sec.lines) == 0 {
+			continue
+		}
+
+		if sec.header != "" {
+			fmt.Fprintf(w, "\n### %s\n", sec.header)
+		}
+
+		for _, line := range sec.lines {
+			fmt.Fprintln(w, line)
+		}
+	}
+
+	return nil
+}
+
+// releaseSection pairs a release's section heading with its entries.
+type releaseSection struct {
+	lines  []string
+	header string
+}
+
+// releaseSections lists r's sections in ToMarkdown's order.
+func releaseSections(r *Release) []releaseSection {
+	return []releaseSection{
+		{r.Other, ""},
+		{r.Added, "Added"},
+		{r.Changed, "Changed"},
+		{r.Deprecated, "Deprecated"},
+		{r.Fixed, "Fixed"},
+		{r.Removed, "Removed"},
+		{r.Security, "Security"},
+	}
+}
+
+// trimBullet strips a leading markdown bullet ("- ") from line, if present.
+func trimBullet(line string) string {
+	return strings.TrimPrefix(strings.TrimSpace(line), "- ")
+}
+
+// stripInlineLinks replaces inline markdown links with "label (url)".
+func stripInlineLinks(text string) string {
+	return inlineLinkRegex.ReplaceAllString(text, "$1 ($2)")
+}
+
+// renderInlineHTML HTML-escapes text and converts any inline
+// "[text](url)" markdown links into anchors, without pulling in a full
+// markdown engine.
+func renderInlineHTML(text string) string {
+	matches := inlineLinkRegex.FindAllStringSubmatchIndex(text, -1)
+	if matches == nil {
+		return html.EscapeString(text)
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		start, end := m[0], m[1]
+		labelStart, labelEnd := m[2], m[3]
+		urlStart, urlEnd := m[4], m[5]
+
+		b.WriteString(html.EscapeString(text[last:start]))
+		b.WriteString(`<a href="`)
+		b.WriteString(html.EscapeString(text[urlStart:urlEnd]))
+		b.WriteString(`">`)
+		b.WriteString(html.EscapeString(text[labelStart:labelEnd]))
+		b.WriteString(`</a>`)
+
+		last = end
+	}
+	b.WriteString(html.EscapeString(text[last:]))
+
+	return b.String()
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+package changelog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdownRendererMatchesToMarkdown(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	var buf strings.Builder
+	assert.Nil(MarkdownRenderer{}.Render(&buf, cl))
+	assert.Equal(cl.ToMarkdown(), buf.String())
+}
+
+func TestHTMLRenderer(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	var buf strings.Builder
+	assert.Nil(HTMLRenderer{}.Render(&buf, cl))
+
+	out := buf.String()
+	assert.Contains(out, "<h1>Changelog</h1>")
+	assert.Contains(out, "<h2>[v3.0.0] - 2020-12-30</h2>")
+	assert.Contains(out, `<a href="https://example.com/issue-55">issue 55</a>`)
+}
+
+func TestPlainTextRenderer(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	var buf strings.Builder
+	assert.Nil(PlainTextRenderer{}.Render(&buf, cl))
+
+	out := buf.String()
+	assert.Contains(out, "Changelog\n=========")
+	assert.Contains(out, "issue 55 (https://example.com/issue-55)")
+	assert.NotContains(out, "### Added")
+}
+
+func TestGitHubReleaseRenderer(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	var buf strings.Builder
+	assert.Nil(GitHubReleaseRenderer{}.Render(&buf, cl))
+
+	out := buf.String()
+	assert.Contains(out, "## [v3.4.0](https://example.com/compare/v3.0.0...v3.4.0)")
+	assert.Contains(out, "### Added")
+}
+
+func TestGitHubReleaseRendererRenderRelease(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	r, ok := cl.Release("v2.0.0")
+	assert.True(ok)
+
+	var buf strings.Builder
+	renderer := GitHubReleaseRenderer{Links: cl.Links}
+	assert.Nil(renderer.RenderRelease(&buf, r))
+	assert.Contains(buf.String(), "## [v2.0.0] [YANKED]")
+}
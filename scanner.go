@@ -0,0 +1,45 @@
+/**
+ * Copyright 2021 Comcast Cable Communications Management, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package changelog
+
+import (
+	"bufio"
+	"io"
+)
+
+// lineScanner wraps a bufio.Scanner to additionally track the 1-based
+// line number of the most recently scanned line, so Release and Link
+// values can record where in the file they came from.
+type lineScanner struct {
+	*bufio.Scanner
+	line int
+}
+
+// newLineScanner creates a lineScanner over r.
+func newLineScanner(r io.Reader) *lineScanner {
+	return &lineScanner{Scanner: bufio.NewScanner(r)}
+}
+
+// Scan advances the scanner, incrementing line on success.
+func (s *lineScanner) Scan() bool {
+	ok := s.Scanner.Scan()
+	if ok {
+		s.line++
+	}
+	return ok
+}
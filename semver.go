@@ -0,0 +1,157 @@
+/**
+ * Copyright 2021 Comcast Cable Communications Management, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// semVer is a parsed semantic version, split into its numeric core and its
+// optional dot-separated pre-release identifiers so it can be compared
+// following the precedence rules in https://semver.org/#spec-item-11.
+type semVer struct {
+	major, minor, patch int
+	pre                 []string
+}
+
+// parseSemVer parses a semantic version string, tolerating an optional
+// leading 'v' and ignoring any '+build' metadata, which does not factor
+// into precedence.
+func parseSemVer(s string) (semVer, error) {
+	orig := s
+	s = strings.TrimPrefix(s, "v")
+
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s = s[:i]
+	}
+
+	core := s
+	var pre []string
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		core = s[:i]
+		pre = strings.Split(s[i+1:], ".")
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return semVer{}, fmt.Errorf("invalid semantic version: '%s'", orig)
+	}
+
+	nums := make([]int, 3)
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return semVer{}, fmt.Errorf("invalid semantic version: '%s'", orig)
+		}
+		nums[i] = n
+	}
+
+	return semVer{major: nums[0], minor: nums[1], patch: nums[2], pre: pre}, nil
+}
+
+// String renders the numeric core of the version as 'major.minor.patch'.
+// Pre-release identifiers are intentionally dropped since callers only use
+// this to render a freshly computed version.
+func (v semVer) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+}
+
+// compare returns -1, 0, or 1 as v is less than, equal to, or greater than
+// o, per semver precedence: numeric identifiers compare numerically, a
+// pre-release version has lower precedence than the associated normal
+// version, and each dot-separated pre-release identifier is compared in
+// turn, numeric ones numerically and alphanumeric ones lexically.
+func (v semVer) compare(o semVer) int {
+	if c := cmpInt(v.major, o.major); c != 0 {
+		return c
+	}
+	if c := cmpInt(v.minor, o.minor); c != 0 {
+		return c
+	}
+	if c := cmpInt(v.patch, o.patch); c != 0 {
+		return c
+	}
+
+	switch {
+	case len(v.pre) == 0 && len(o.pre) == 0:
+		return 0
+	case len(v.pre) == 0:
+		return 1
+	case len(o.pre) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.pre) && i < len(o.pre); i++ {
+		if c := comparePreRelease(v.pre[i], o.pre[i]); c != 0 {
+			return c
+		}
+	}
+
+	return cmpInt(len(v.pre), len(o.pre))
+}
+
+// comparePreRelease compares a single dot-separated pre-release identifier
+// pair.  Identifiers consisting only of digits are compared numerically;
+// otherwise they are compared lexically.  A numeric identifier always has
+// lower precedence than an alphanumeric one.
+func comparePreRelease(a, b string) int {
+	an, aErr := strconv.Atoi(a)
+	bn, bErr := strconv.Atoi(b)
+
+	switch {
+	case aErr == nil && bErr == nil:
+		return cmpInt(an, bn)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func cmpInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareSemVer compares two semantic version strings per semver
+// precedence rules.  Either version may carry an optional leading 'v'.  It
+// returns -1, 0, or 1 as a is less than, equal to, or greater than b, or an
+// error if either string is not valid semver.
+func compareSemVer(a, b string) (int, error) {
+	av, err := parseSemVer(a)
+	if err != nil {
+		return 0, err
+	}
+
+	bv, err := parseSemVer(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return av.compare(bv), nil
+}
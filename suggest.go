@@ -0,0 +1,192 @@
+/**
+ * Copyright 2021 Comcast Cable Communications Management, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BumpKind identifies the kind of semantic version bump a release's
+// content implies.
+type BumpKind int
+
+const (
+	// NoBump means the release carries no entries that imply any bump.
+	NoBump BumpKind = iota
+
+	// PatchBump means only backwards compatible bug fixes were recorded.
+	PatchBump
+
+	// MinorBump means backwards compatible functionality was recorded.
+	MinorBump
+
+	// MajorBump means a breaking change was recorded.
+	MajorBump
+)
+
+// String renders the bump kind the way it would appear in a semantic
+// version, e.g. when explaining a suggestion to a user.
+func (k BumpKind) String() string {
+	switch k {
+	case MajorBump:
+		return "major"
+	case MinorBump:
+		return "minor"
+	case PatchBump:
+		return "patch"
+	default:
+		return "none"
+	}
+}
+
+// Reason explains why SuggestVersion recommended a particular version.
+type Reason struct {
+	// Kind is the bump SuggestVersion actually applied to base.  For a
+	// v0.x base this may be lower severity than the release's own
+	// BumpKind(), since a v0.x module hasn't committed to a stable API
+	// (see SuggestVersion).
+	Kind BumpKind
+
+	// Sections lists the non-empty section headers that drove the
+	// decision, e.g. ["Removed", "Changed"].  These reflect the
+	// release's own BumpKind(), even when Kind was downgraded for a v0.x
+	// base.
+	Sections []string
+}
+
+// BumpKind inspects this release's sections and reports the semantic
+// version bump it implies.  The mapping follows the approach used by
+// golang.org/x/exp/cmd/gorelease's report: any Removed or Changed entry is
+// treated as describing breaking behavior (major), Added or Deprecated as
+// a backwards compatible addition (minor), and Fixed or Security, on their
+// own, as a patch-level fix.  A release with no entries in any of those
+// sections implies NoBump.
+func (r *Release) BumpKind() BumpKind {
+	if len(r.Removed) > 0 || len(r.Changed) > 0 {
+		return MajorBump
+	}
+	if len(r.Added) > 0 || len(r.Deprecated) > 0 {
+		return MinorBump
+	}
+	if len(r.Fixed) > 0 || len(r.Security) > 0 {
+		return PatchBump
+	}
+
+	return NoBump
+}
+
+// sectionsFor lists the non-empty sections that correspond to the given
+// bump kind, in a fixed order: Removed before Changed, Added before
+// Deprecated, and Fixed before Security.
+func (r *Release) sectionsFor(kind BumpKind) []string {
+	var names []string
+
+	add := func(name string, lines []string) {
+		if len(lines) > 0 {
+			names = append(names, name)
+		}
+	}
+
+	switch kind {
+	case MajorBump:
+		add("Removed", r.Removed)
+		add("Changed", r.Changed)
+	case MinorBump:
+		add("Added", r.Added)
+		add("Deprecated", r.Deprecated)
+	case PatchBump:
+		add("Fixed", r.Fixed)
+		add("Security", r.Security)
+	}
+
+	return names
+}
+
+// unreleased finds the Unreleased release, if one is present.
+func (cl *Changelog) unreleased() (*Release, bool) {
+	for i := range cl.Releases {
+		if strings.EqualFold(cl.Releases[i].Version, "unreleased") {
+			return &cl.Releases[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// SuggestVersion recommends the next semantic version for the Unreleased
+// release relative to base, following the same rules `go mod` applies to
+// module versioning: for a base at v1.0.0 or later, a breaking change
+// requires a new major version, but for a base still in v0.x the module
+// hasn't committed to a stable API yet, so a breaking change only bumps
+// the minor version and a compatible addition only bumps the patch
+// version.
+//
+// It returns the suggested version (carrying a leading 'v' if base had
+// one), the Reason behind it, and an error if base isn't valid semver or
+// there is no Unreleased release to inspect.  If the Unreleased release
+// has no entries, the suggested version is empty and Reason.Kind is
+// NoBump.
+func (cl *Changelog) SuggestVersion(base string) (string, Reason, error) {
+	rel, ok := cl.unreleased()
+	if !ok {
+		return "", Reason{}, fmt.Errorf("no Unreleased release found")
+	}
+
+	bv, err := parseSemVer(base)
+	if err != nil {
+		return "", Reason{}, fmt.Errorf("invalid base version '%s': %w", base, err)
+	}
+
+	kind := rel.BumpKind()
+	sections := rel.sectionsFor(kind)
+	if kind == NoBump {
+		return "", Reason{Kind: NoBump, Sections: sections}, nil
+	}
+
+	if bv.major == 0 {
+		switch kind {
+		case MajorBump:
+			kind = MinorBump
+		case MinorBump:
+			kind = PatchBump
+		}
+	}
+
+	reason := Reason{Kind: kind, Sections: sections}
+
+	next := bv
+	next.pre = nil
+	switch kind {
+	case MajorBump:
+		next.major++
+		next.minor, next.patch = 0, 0
+	case MinorBump:
+		next.minor++
+		next.patch = 0
+	case PatchBump:
+		next.patch++
+	}
+
+	prefix := ""
+	if strings.HasPrefix(base, "v") {
+		prefix = "v"
+	}
+
+	return prefix + next.String(), reason, nil
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+package changelog
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func getUnreleased(body string) io.Reader {
+	full := "# Changelog\n\n## [Unreleased]\n" + body
+	return strings.NewReader(full)
+}
+
+func TestSuggestVersion(t *testing.T) {
+	tests := []struct {
+		description string
+		body        string
+		base        string
+		want        string
+		wantKind    BumpKind
+	}{
+		{
+			description: "a removed entry is a breaking change",
+			body:        "### Removed\n- The Old() function.\n",
+			base:        "v1.2.3",
+			want:        "v2.0.0",
+			wantKind:    MajorBump,
+		},
+		{
+			description: "a changed entry is a breaking change",
+			body:        "### Changed\n- Behavior of Foo() changed.\n",
+			base:        "1.2.3",
+			want:        "2.0.0",
+			wantKind:    MajorBump,
+		},
+		{
+			description: "an added entry is a minor bump",
+			body:        "### Added\n- A new New() function.\n",
+			base:        "v1.2.3",
+			want:        "v1.3.0",
+			wantKind:    MinorBump,
+		},
+		{
+			description: "a fixed entry is a patch bump",
+			body:        "### Fixed\n- A panic in Foo().\n",
+			base:        "v1.2.3",
+			want:        "v1.2.4",
+			wantKind:    PatchBump,
+		},
+		{
+			description: "a v0.x breaking change only bumps the minor version",
+			body:        "### Removed\n- The Old() function.\n",
+			base:        "v0.4.3",
+			want:        "v0.5.0",
+			wantKind:    MinorBump,
+		},
+		{
+			description: "a v0.x compatible addition only bumps the patch version",
+			body:        "### Added\n- A new New() function.\n",
+			base:        "v0.4.3",
+			want:        "v0.4.4",
+			wantKind:    PatchBump,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			assert := assert.New(t)
+
+			cl, err := Parse(getUnreleased(tc.body))
+			assert.NotNil(cl)
+			assert.Nil(err)
+
+			got, reason, err := cl.SuggestVersion(tc.base)
+			assert.Nil(err)
+			assert.Equal(tc.want, got)
+			assert.Equal(tc.wantKind, reason.Kind)
+			assert.NotEmpty(reason.Sections)
+		})
+	}
+}
+
+func TestSuggestVersionNoChanges(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getUnreleased(""))
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	got, reason, err := cl.SuggestVersion("v1.2.3")
+	assert.Nil(err)
+	assert.Equal("", got)
+	assert.Equal(NoBump, reason.Kind)
+	assert.Empty(reason.Sections)
+}
+
+func TestSuggestVersionInvalidBase(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getUnreleased("### Added\n- Something.\n"))
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	_, _, err = cl.SuggestVersion("not-a-version")
+	assert.NotNil(err)
+}
+
+func TestSuggestVersionNoUnreleased(t *testing.T) {
+	assert := assert.New(t)
+
+	body := "# Changelog\n\n## [v1.0.0]\n### Added\n- Something.\n"
+	cl, err := Parse(strings.NewReader(body))
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	_, _, err = cl.SuggestVersion("v1.0.0")
+	assert.NotNil(err)
+}
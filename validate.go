@@ -0,0 +1,198 @@
+/**
+ * Copyright 2021 Comcast Cable Communications Management, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// unreleasedDateRegex matches a YYYY-MM-DD date appearing anywhere in an
+// Unreleased release's title.  Parse discards the date it finds there
+// (see newRelease), so this is the only way to tell one was present.
+var unreleasedDateRegex = regexp.MustCompile(`\d{4}-\d\d-\d\d`)
+
+// Severity indicates how serious a Diagnostic is.
+type Severity int
+
+const (
+	// Warning flags something that is almost certainly a mistake, but
+	// doesn't stop the file from being parsed.
+	Warning Severity = iota
+
+	// Error flags something that makes a value unreliable, e.g. a
+	// version that can't be used for semver comparisons.
+	Error
+)
+
+// String renders the severity the way it would appear in a linter's
+// output, e.g. "warning" or "error".
+func (s Severity) String() string {
+	switch s {
+	case Error:
+		return "error"
+	default:
+		return "warning"
+	}
+}
+
+// Diagnostic describes a single problem found by Validate.
+type Diagnostic struct {
+	// Line is the 1-based line number the problem was found at.
+	Line int
+
+	// Severity is how serious the problem is.
+	Severity Severity
+
+	// Rule is the id of the rule that was violated, e.g. "KAC001".
+	Rule string
+
+	// Message is a human readable description of the problem.
+	Message string
+}
+
+// ValidateOptions configures Validate.
+type ValidateOptions struct {
+	// IgnoreRules lists rule ids, e.g. "KAC005", whose diagnostics should
+	// be omitted from the result.
+	IgnoreRules []string
+}
+
+// ignored reports whether opts suppresses rule.
+func (opts ValidateOptions) ignored(rule string) bool {
+	for _, id := range opts.IgnoreRules {
+		if id == rule {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate reports problems that Parse otherwise silently accepts:
+// releases without a date (other than Unreleased), release versions that
+// aren't valid semver, unrecognized '### ...' section headings, a section
+// heading repeated within one release, releases listed out of descending
+// version order, an Unreleased release carrying a date, and releases or
+// links that don't have a matching counterpart in the other.
+func (cl *Changelog) Validate(opts ValidateOptions) []Diagnostic {
+	var diags []Diagnostic
+
+	add := func(rule string, severity Severity, line int, format string, args ...interface{}) {
+		if opts.ignored(rule) {
+			return
+		}
+
+		diags = append(diags, Diagnostic{
+			Line:     line,
+			Severity: severity,
+			Rule:     rule,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	var lastVersion string
+	haveLastVersion := false
+
+	for i := range cl.Releases {
+		r := &cl.Releases[i]
+
+		if strings.EqualFold(r.Version, "unreleased") {
+			if unreleasedDateRegex.MatchString(r.Title) {
+				add("KAC006", Warning, r.Line, "Unreleased release should not carry a date")
+			}
+		} else {
+			if r.Date == nil {
+				add("KAC001", Warning, r.Line, "release '%s' is missing a date", r.Version)
+			}
+
+			v, err := parseSemVer(r.Version)
+			if err != nil {
+				add("KAC002", Error, r.Line, "release version '%s' is not valid semantic version", r.Version)
+			} else {
+				if haveLastVersion {
+					if lv, lErr := parseSemVer(lastVersion); lErr == nil && v.compare(lv) > 0 {
+						add("KAC005", Warning, r.Line, "release '%s' is out of descending version order after '%s'", r.Version, lastVersion)
+					}
+				}
+
+				lastVersion = r.Version
+				haveLastVersion = true
+			}
+		}
+
+		seen := map[string]bool{}
+		for _, h := range r.headings {
+			if !h.known {
+				add("KAC003", Warning, h.line, "unrecognized section heading '### %s'", h.name)
+				continue
+			}
+
+			if seen[h.name] {
+				add("KAC004", Warning, h.line, "duplicate '### %s' section in release '%s'", h.name, r.Version)
+			}
+			seen[h.name] = true
+		}
+	}
+
+	for i := range cl.Releases {
+		r := &cl.Releases[i]
+		if !cl.hasLink(r.Version) {
+			add("KAC007", Warning, r.Line, "release '%s' has no entry in the Links block", r.Version)
+		}
+	}
+
+	for _, l := range cl.Links {
+		found := false
+		for i := range cl.Releases {
+			if strings.EqualFold(cl.Releases[i].Version, l.Version) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			add("KAC007", Warning, l.Line, "link '%s' has no matching release", l.Version)
+		}
+	}
+
+	return diags
+}
+
+// canonicalSection normalizes a recognized section heading's captured
+// text (which may carry whatever case the file used, e.g. "added") to its
+// canonical title-cased form, e.g. "Added".
+func canonicalSection(raw string) string {
+	switch strings.ToLower(raw) {
+	case "added":
+		return "Added"
+	case "changed":
+		return "Changed"
+	case "deprecated":
+		return "Deprecated"
+	case "removed":
+		return "Removed"
+	case "fixed":
+		return "Fixed"
+	case "security":
+		return "Security"
+	default:
+		return raw
+	}
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+package changelog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func diagRules(diags []Diagnostic) []string {
+	rules := make([]string, 0, len(diags))
+	for _, d := range diags {
+		rules = append(rules, d.Rule)
+	}
+	return rules
+}
+
+func TestValidateStrict(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	diags := cl.Validate(ValidateOptions{})
+
+	assert.Contains(diagRules(diags), "KAC001") // v3.4.0 has no date
+	assert.Contains(diagRules(diags), "KAC004") // duplicate ### Changed in v3.4.0
+	assert.Contains(diagRules(diags), "KAC007") // v2.1.0/v2.0.0 aren't linked
+}
+
+func TestValidateIgnoreRules(t *testing.T) {
+	assert := assert.New(t)
+
+	cl, err := Parse(getStrict())
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	diags := cl.Validate(ValidateOptions{IgnoreRules: []string{"KAC001", "KAC004", "KAC007"}})
+	assert.Empty(diags)
+}
+
+func TestValidateUnknownSectionAndOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	body := `
+# Changelog
+
+## [v1.0.0] - 2021-01-01
+### Notes
+- This heading isn't one Keep a Changelog recognizes.
+
+## [v2.0.0] - 2021-02-01
+### Added
+- Something.
+`
+	cl, err := Parse(strings.NewReader(body))
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	diags := cl.Validate(ValidateOptions{})
+
+	rules := diagRules(diags)
+	assert.Contains(rules, "KAC003") // unknown ### Notes heading
+	assert.Contains(rules, "KAC005") // v1.0.0 after v2.0.0 is out of order
+}
+
+func TestValidateUnreleasedWithDate(t *testing.T) {
+	assert := assert.New(t)
+
+	body := `
+# Changelog
+
+## [Unreleased] - 2021-01-01
+- Shouldn't have a date.
+`
+	cl, err := Parse(strings.NewReader(body))
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	diags := cl.Validate(ValidateOptions{})
+	assert.Contains(diagRules(diags), "KAC006")
+}
+
+func TestValidateInvalidSemVer(t *testing.T) {
+	assert := assert.New(t)
+
+	body := `
+# Changelog
+
+## [not-a-version] - 2021-01-01
+- Bad version.
+`
+	cl, err := Parse(strings.NewReader(body))
+	assert.NotNil(cl)
+	assert.Nil(err)
+
+	diags := cl.Validate(ValidateOptions{})
+	assert.Contains(diagRules(diags), "KAC002")
+}